@@ -44,6 +44,11 @@ type ProviderSpec struct {
 	// Tresor specifies the Tresor provider configuration
 	// +optional
 	Tresor *TresorProviderSpec `json:"tresor,omitempty"`
+
+	// BYO specifies the Bring-Your-Own provider configuration, allowing a
+	// user-supplied root CA to be used instead of one generated by OSM.
+	// +optional
+	BYO *BYOProviderSpec `json:"byo,omitempty"`
 }
 
 // CertManagerProviderSpec defines the configuration of the cert-manager provider
@@ -83,6 +88,16 @@ type TresorProviderSpec struct {
 	SecretName string `json:"secretName"`
 }
 
+// BYOProviderSpec defines the configuration of the Bring-Your-Own CA
+// provider. The referenced Secret must be created by the user ahead of
+// time and is never written to by OSM.
+type BYOProviderSpec struct {
+	// SecretName specifies the name of the user-provided secret containing
+	// the root certificate, under keys "ca.crt" and "ca.key", and
+	// optionally an intermediate chain under "ca.intermediate.crt".
+	SecretName string `json:"secretName"`
+}
+
 // MeshRootCertificateStatus defines the status of the MeshRootCertificate resource
 type MeshRootCertificateStatus struct {
 	// State specifies the state of the root certificate rotation
@@ -93,8 +108,61 @@ type MeshRootCertificateStatus struct {
 	// meaning of the RotationStage status is determined by the accompanying
 	// State status
 	RotationStage string `json:"rotationStage"`
+
+	// PreviousMRCName references the MeshRootCertificate this one is
+	// replacing, if this MRC was created to rotate out a prior root. Once
+	// RotationStage reaches MRCStageComplete, the referenced
+	// MeshRootCertificate is deleted.
+	// +optional
+	PreviousMRCName string `json:"previousMRCName,omitempty"`
 }
 
+// MeshRootCertificate rotation states. A MeshRootCertificate is either
+// actively being rolled out or has completed rollout and is simply
+// retained for reference.
+const (
+	// MRCStateActive indicates the MeshRootCertificate is progressing
+	// through its rotation stages.
+	MRCStateActive = "active"
+
+	// MRCStateComplete indicates the MeshRootCertificate has finished
+	// rotating and the RotationStage is MRCStageComplete.
+	MRCStateComplete = "complete"
+
+	// MRCStateError indicates the rotation cannot make forward progress.
+	// The rotation controller skips MRCs in this state but never sets it
+	// itself: it waits for proxy ACKs without a time limit.
+	MRCStateError = "error"
+)
+
+// MeshRootCertificate rotation stages. Stages are ordered and a
+// MeshRootCertificate only ever advances to the next stage once every
+// Envoy in the mesh has ACKed the trust bundle implied by the current
+// stage.
+const (
+	// MRCStageIssued is the initial stage of a newly created MRC: the new
+	// root exists, but the existing CA is still the only one signing and
+	// the only one trusted.
+	MRCStageIssued = "issued"
+
+	// MRCStageIssuingRollout indicates proxies are being pushed a trust
+	// bundle containing both the old and new root, while the old CA
+	// keeps signing leaf certificates.
+	MRCStageIssuingRollout = "issuingRollout"
+
+	// MRCStageIssuingActive indicates the new CA has taken over signing
+	// leaf certificates, while both roots remain trusted by proxies.
+	MRCStageIssuingActive = "issuingActive"
+
+	// MRCStageRetiring indicates proxies are being pushed a trust bundle
+	// containing only the new root; the old root is no longer trusted.
+	MRCStageRetiring = "retiring"
+
+	// MRCStageComplete indicates the rotation has finished; the old MRC
+	// is eligible for deletion.
+	MRCStageComplete = "complete"
+)
+
 // MeshRootCertificateList defines the list of MeshRootCertificate objects
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 type MeshRootCertificateList struct {
@@ -0,0 +1,163 @@
+package certificate
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1informers "k8s.io/client-go/informers/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/openservicemesh/osm/pkg/certificate/pem"
+	"github.com/openservicemesh/osm/pkg/constants"
+	"github.com/openservicemesh/osm/pkg/errcode"
+)
+
+// certSecretCrtKey is the key under which a managed certificate's leaf PEM
+// is stored in its owning Secret's Data.
+const certSecretCrtKey = "crt.pem"
+
+// certExpirerController watches the Secrets OSM uses to store managed
+// certificates and deletes a Secret once its certificate is due for
+// renewal, relying on the existing issuance path to recreate it with a
+// freshly signed certificate. This mirrors the approach Pinniped's
+// certsExpirerController takes to keep its serving certificate fresh.
+type certExpirerController struct {
+	kubeClient     kubernetes.Interface
+	secretInformer corev1informers.SecretInformer
+	queue          workqueue.RateLimitingInterface
+	meshNamespace  string
+	renewBefore    time.Duration
+}
+
+// NewCertExpirerController returns a controller that proactively deletes
+// managed certificate Secrets in meshNamespace once they reach the renewal
+// point computed by renewalTime (a proportion of the certificate's
+// lifetime, never later than renewBefore ahead of expiration), so that
+// the issuance path reissues them. The
+// informer is expected to be scoped (or will be filtered here) to Secrets
+// carrying the OSM monitor annotation.
+func NewCertExpirerController(
+	kubeClient kubernetes.Interface,
+	secretInformer corev1informers.SecretInformer,
+	meshNamespace string,
+	renewBefore time.Duration,
+) *certExpirerController {
+	c := &certExpirerController{
+		kubeClient:     kubeClient,
+		secretInformer: secretInformer,
+		queue:          workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
+		meshNamespace:  meshNamespace,
+		renewBefore:    renewBefore,
+	}
+
+	secretInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(obj interface{}) { c.enqueue(obj) },
+		UpdateFunc: func(_, newObj interface{}) { c.enqueue(newObj) },
+	})
+
+	return c
+}
+
+func (c *certExpirerController) enqueue(obj interface{}) {
+	secret, ok := obj.(*corev1.Secret)
+	if !ok {
+		return
+	}
+	if secret.Namespace != c.meshNamespace {
+		return
+	}
+	if _, monitored := secret.Labels[constants.OSMKubeResourceMonitorAnnotation]; !monitored {
+		return
+	}
+
+	key, err := cache.MetaNamespaceKeyFunc(secret)
+	if err != nil {
+		log.Error().Err(err).Msgf("error computing cache key for secret %s/%s", secret.Namespace, secret.Name)
+		return
+	}
+	c.queue.Add(key)
+}
+
+// Run starts a single worker processing the expiry queue until stop is closed.
+func (c *certExpirerController) Run(ctx context.Context, stop <-chan struct{}) {
+	defer c.queue.ShutDown()
+
+	go wait(ctx, c.queue, c.processNextItem)
+
+	<-stop
+}
+
+// wait runs fn in a loop, pulling keys off queue, until the queue shuts down.
+func wait(ctx context.Context, queue workqueue.RateLimitingInterface, fn func(ctx context.Context, key string) error) {
+	for {
+		key, shutdown := queue.Get()
+		if shutdown {
+			return
+		}
+
+		func() {
+			defer queue.Done(key)
+			if err := fn(ctx, key.(string)); err != nil {
+				log.Error().Err(err).Msgf("error processing secret %s for expiry check", key)
+				queue.AddRateLimited(key)
+				return
+			}
+			queue.Forget(key)
+		}()
+	}
+}
+
+func (c *certExpirerController) processNextItem(ctx context.Context, key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return fmt.Errorf("invalid resource key %q: %w", key, err)
+	}
+
+	secret, err := c.secretInformer.Lister().Secrets(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("getting secret %s: %w", key, err)
+	}
+
+	return c.syncSecret(ctx, secret)
+}
+
+// syncSecret decodes the certificate stored in secret and either deletes
+// it, if it is due for renewal, or requeues it for the time at which it
+// will become due.
+func (c *certExpirerController) syncSecret(ctx context.Context, secret *corev1.Secret) error {
+	crtPEM, ok := secret.Data[certSecretCrtKey]
+	if !ok {
+		return nil
+	}
+
+	x509Cert, err := DecodePEMCertificate(pem.Certificate(crtPEM))
+	if err != nil {
+		log.Error().Err(err).Str(errcode.Kind, errcode.GetErrCodeWithMetric(errcode.ErrDecodingPEMCert)).
+			Msgf("error decoding PEM certificate in secret %s/%s, skipping", secret.Namespace, secret.Name)
+		return nil
+	}
+
+	renewAt := renewalTime(x509Cert.NotBefore, x509Cert.NotAfter, c.renewBefore, 0)
+
+	if !time.Now().Before(renewAt) {
+		log.Info().Msgf("certificate in secret %s/%s is due for renewal, deleting secret", secret.Namespace, secret.Name)
+		err := c.kubeClient.CoreV1().Secrets(secret.Namespace).Delete(ctx, secret.Name, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("deleting secret %s/%s: %w", secret.Namespace, secret.Name, err)
+		}
+		return nil
+	}
+
+	c.queue.AddAfter(secret.Namespace+"/"+secret.Name, time.Until(renewAt))
+	return nil
+}
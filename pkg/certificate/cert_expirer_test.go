@@ -0,0 +1,129 @@
+package certificate
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclient "k8s.io/client-go/kubernetes/fake"
+	core_testing "k8s.io/client-go/testing"
+	"k8s.io/client-go/util/workqueue"
+)
+
+func fabricateSecret(t *testing.T, name string, notBefore, notAfter time.Time) *corev1.Secret {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+
+	crtPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "osm-system"},
+		Data:       map[string][]byte{certSecretCrtKey: crtPEM},
+	}
+}
+
+func hasDeleteAction(actions []core_testing.Action, namespace, name string) bool {
+	for _, action := range actions {
+		deleteAction, ok := action.(core_testing.DeleteAction)
+		if !ok {
+			continue
+		}
+		if deleteAction.GetNamespace() == namespace && deleteAction.GetName() == name {
+			return true
+		}
+	}
+	return false
+}
+
+func TestCertExpirerSyncSecret(t *testing.T) {
+	testCases := []struct {
+		name         string
+		notBefore    time.Time
+		notAfter     time.Time
+		renewBefore  time.Duration
+		expectDelete bool
+	}{
+		{
+			name:         "certificate well within its validity window is left alone",
+			notBefore:    time.Now().Add(-time.Hour),
+			notAfter:     time.Now().Add(24 * time.Hour),
+			renewBefore:  RenewBeforeCertExpires,
+			expectDelete: false,
+		},
+		{
+			name:         "certificate inside the renewal window is deleted",
+			notBefore:    time.Now().Add(-24 * time.Hour),
+			notAfter:     time.Now().Add(10 * time.Second),
+			renewBefore:  RenewBeforeCertExpires,
+			expectDelete: true,
+		},
+		{
+			name:         "already-expired certificate is deleted",
+			notBefore:    time.Now().Add(-48 * time.Hour),
+			notAfter:     time.Now().Add(-time.Hour),
+			renewBefore:  RenewBeforeCertExpires,
+			expectDelete: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			secret := fabricateSecret(t, "test-cert", tc.notBefore, tc.notAfter)
+			kubeClient := testclient.NewSimpleClientset(secret)
+
+			c := &certExpirerController{
+				kubeClient:    kubeClient,
+				meshNamespace: secret.Namespace,
+				renewBefore:   tc.renewBefore,
+				queue:         workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
+			}
+
+			err := c.syncSecret(context.Background(), secret)
+			assert.NoError(t, err)
+
+			assert.Equal(t, tc.expectDelete, hasDeleteAction(kubeClient.Actions(), secret.Namespace, secret.Name))
+		})
+	}
+}
+
+func TestCertExpirerSyncSecretMalformedPEM(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "bad-cert", Namespace: "osm-system"},
+		Data:       map[string][]byte{certSecretCrtKey: []byte("not a certificate")},
+	}
+	kubeClient := testclient.NewSimpleClientset(secret)
+
+	c := &certExpirerController{
+		kubeClient:    kubeClient,
+		meshNamespace: secret.Namespace,
+		renewBefore:   RenewBeforeCertExpires,
+		queue:         workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
+	}
+
+	err := c.syncSecret(context.Background(), secret)
+	assert.NoError(t, err)
+	assert.False(t, hasDeleteAction(kubeClient.Actions(), secret.Namespace, secret.Name))
+}
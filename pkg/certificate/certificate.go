@@ -11,13 +11,29 @@ import (
 )
 
 const (
-	// RenewBeforeCertExpires signifies how much earlier (before expiration) should a certificate be renewed
+	// RenewBeforeCertExpires signifies the minimum amount of time
+	// (before expiration) a certificate must be renewed by, regardless of
+	// what the proportional schedule below computes. This matters most
+	// for very short-lived certificates, where a percentage of their
+	// lifetime would otherwise leave too little time to reissue them.
 	RenewBeforeCertExpires = 30 * time.Second
 
-	// So that we do not renew all certs at the same time - add noise.
-	// These define the min and max of the seconds of noise to be added
-	// to the early certificate renewal.
-	noiseSeconds = 5
+	// DefaultRotatePercentage is the fraction of a certificate's total
+	// lifetime (NotAfter - NotBefore) after which it should be renewed,
+	// used when a Certificate does not set its own RotatePercentage.
+	// Two thirds strikes a balance for the common, medium-lived service
+	// certs: renewal happens well before expiry, but not so early that a
+	// cert is perpetually being re-issued.
+	DefaultRotatePercentage = 2.0 / 3.0
+
+	// maxJitterPercentage caps the jitter added ahead of the renewal
+	// point at this fraction of the certificate's total lifetime.
+	maxJitterPercentage = 0.05
+
+	// maxJitter is an absolute ceiling on jitter, so that long-lived
+	// certificates (e.g. the mesh root, valid for years) do not end up
+	// with jitter windows of days.
+	maxJitter = 1 * time.Hour
 )
 
 // GetCommonName returns the Common Name of the certificate
@@ -52,14 +68,44 @@ func (c *Certificate) GetIssuingCA() pem.RootCertificate {
 
 // ShouldRotate determines whether a certificate should be rotated.
 func (c *Certificate) ShouldRotate() bool {
-	// The certificate is going to expire at a timestamp T
-	// We want to renew earlier. How much earlier is defined in renewBeforeCertExpires.
-	// We add a few seconds noise to the early renew period so that certificates that may have been
-	// created at the same time are not renewed at the exact same time.
-
-	intNoise := rand.Intn(noiseSeconds) // #nosec G404
-	secondsNoise := time.Duration(intNoise) * time.Second
-	return time.Until(c.GetExpiration()) <= (RenewBeforeCertExpires + secondsNoise)
+	return !time.Now().Before(renewalTime(c.NotBefore, c.Expiration, RenewBeforeCertExpires, c.RotatePercentage))
+}
+
+// renewalTime computes the point in time at which a certificate valid from
+// notBefore to notAfter should be renewed.
+//
+// The renewal point is rotatePercentage of the way through the
+// certificate's total lifetime (NotBefore to NotAfter), falling back to
+// DefaultRotatePercentage when rotatePercentage is zero. renewBefore acts
+// as a floor: the renewal point is never later than renewBefore ahead of
+// expiration, which matters for very short-lived certificates where a
+// percentage of their lifetime would otherwise leave too little time to
+// reissue them.
+//
+// Jitter scales with the certificate's lifetime (capped at maxJitter) and
+// is subtracted from the renewal point, so that certificates issued at
+// the same time are not all renewed at the exact same time.
+func renewalTime(notBefore, notAfter time.Time, renewBefore time.Duration, rotatePercentage float64) time.Time {
+	if rotatePercentage <= 0 {
+		rotatePercentage = DefaultRotatePercentage
+	}
+
+	lifetime := notAfter.Sub(notBefore)
+	renewAt := notBefore.Add(time.Duration(float64(lifetime) * rotatePercentage))
+
+	if floor := notAfter.Add(-renewBefore); renewAt.After(floor) {
+		renewAt = floor
+	}
+
+	jitter := time.Duration(float64(lifetime) * maxJitterPercentage)
+	if jitter > maxJitter {
+		jitter = maxJitter
+	}
+	if jitter > 0 {
+		renewAt = renewAt.Add(-time.Duration(rand.Int63n(int64(jitter)))) // #nosec G404
+	}
+
+	return renewAt
 }
 
 // NewFromPEM is a helper returning a *certificate.Certificate from the PEM components given.
@@ -78,6 +124,7 @@ func NewFromPEM(pemCert pem.Certificate, pemKey pem.PrivateKey) (*Certificate, e
 		CertChain:    pemCert,
 		IssuingCA:    pem.RootCertificate(pemCert),
 		PrivateKey:   pemKey,
+		NotBefore:    x509Cert.NotBefore,
 		Expiration:   x509Cert.NotAfter,
 	}, nil
 }
@@ -0,0 +1,94 @@
+package certificate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShouldRotate(t *testing.T) {
+	testCases := []struct {
+		name             string
+		lifetime         time.Duration
+		rotatePercentage float64
+		elapsedFraction  float64 // how far into the lifetime "now" is
+		expectRotate     bool
+	}{
+		{
+			name:             "1-minute leaf cert, well before its renewal point",
+			lifetime:         time.Minute,
+			rotatePercentage: 0,
+			elapsedFraction:  0.1,
+			expectRotate:     false,
+		},
+		{
+			name:             "1-minute leaf cert, past its renewal point",
+			lifetime:         time.Minute,
+			rotatePercentage: 0,
+			elapsedFraction:  0.99,
+			expectRotate:     true,
+		},
+		{
+			name:             "24-hour service cert, before the 2/3 renewal point",
+			lifetime:         24 * time.Hour,
+			rotatePercentage: 0,
+			elapsedFraction:  0.5,
+			expectRotate:     false,
+		},
+		{
+			name:             "24-hour service cert, after the 2/3 renewal point",
+			lifetime:         24 * time.Hour,
+			rotatePercentage: 0,
+			elapsedFraction:  0.95,
+			expectRotate:     true,
+		},
+		{
+			name:             "1-year root cert, early in its lifetime",
+			lifetime:         365 * 24 * time.Hour,
+			rotatePercentage: 0,
+			elapsedFraction:  0.1,
+			expectRotate:     false,
+		},
+		{
+			name:             "1-year root cert, past the 2/3 renewal point",
+			lifetime:         365 * 24 * time.Hour,
+			rotatePercentage: 0,
+			elapsedFraction:  0.7,
+			expectRotate:     true,
+		},
+		{
+			name:             "1-year root cert with an overridden low rotate percentage, just past it",
+			lifetime:         365 * 24 * time.Hour,
+			rotatePercentage: 0.1,
+			elapsedFraction:  0.15,
+			expectRotate:     true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			notBefore := time.Now().Add(-time.Duration(tc.elapsedFraction * float64(tc.lifetime)))
+			notAfter := notBefore.Add(tc.lifetime)
+
+			c := &Certificate{
+				NotBefore:        notBefore,
+				Expiration:       notAfter,
+				RotatePercentage: tc.rotatePercentage,
+			}
+
+			assert.Equal(t, tc.expectRotate, c.ShouldRotate())
+		})
+	}
+}
+
+func TestRenewalTimeFloor(t *testing.T) {
+	// A certificate short enough that 2/3 of its lifetime would leave less
+	// than RenewBeforeCertExpires before expiration; the floor should win.
+	notBefore := time.Now()
+	notAfter := notBefore.Add(10 * time.Second)
+
+	renewAt := renewalTime(notBefore, notAfter, RenewBeforeCertExpires, 0)
+	assert.True(t, renewAt.Before(notAfter.Add(-RenewBeforeCertExpires+time.Second)))
+}
@@ -0,0 +1,36 @@
+package certificate
+
+import (
+	"crypto/x509"
+	stdpem "encoding/pem"
+	"fmt"
+
+	"github.com/openservicemesh/osm/pkg/certificate/pem"
+)
+
+// DecodePEMCertificateChain parses every PEM-encoded certificate block in
+// chainPEM, in order, e.g. the intermediate chain accompanying a BYO root
+// CA. Unlike DecodePEMCertificate it does not assume a single certificate.
+func DecodePEMCertificateChain(chainPEM pem.Certificate) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+
+	rest := []byte(chainPEM)
+	for len(rest) > 0 {
+		var block *stdpem.Block
+		block, rest = stdpem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("parsing certificate in chain: %w", err)
+		}
+		certs = append(certs, cert)
+	}
+
+	return certs, nil
+}
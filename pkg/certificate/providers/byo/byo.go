@@ -0,0 +1,149 @@
+// Package byo implements loading of a Bring-Your-Own root CA. It loads a
+// user-populated Kubernetes Secret containing a root CA certificate and
+// key, optionally along with an intermediate chain, and validates it.
+// The package does not issue or sign certificates with the loaded CA.
+package byo
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/openservicemesh/osm/pkg/certificate"
+	"github.com/openservicemesh/osm/pkg/certificate/pem"
+)
+
+// Secret data keys expected in a BYO provider Secret.
+const (
+	// RootCertFileName is the key under which the root CA certificate PEM
+	// is stored.
+	RootCertFileName = "ca.crt"
+
+	// RootKeyFileName is the key under which the root CA private key PEM
+	// is stored.
+	RootKeyFileName = "ca.key"
+
+	// IntermediateCertFileName is the key under which an optional
+	// intermediate certificate chain PEM is stored.
+	IntermediateCertFileName = "ca.intermediate.crt"
+)
+
+// ValidationError indicates the BYO CA referenced by a MeshRootCertificate
+// failed validation and cannot be used as a signing CA.
+type ValidationError struct {
+	// Reason is a short, stable, machine-checkable description of why
+	// validation failed.
+	Reason string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid BYO root CA: %s", e.Reason)
+}
+
+// CABundle holds the material loaded from a BYO provider Secret.
+type CABundle struct {
+	// RootCert is the PEM-encoded root CA certificate.
+	RootCert pem.Certificate
+
+	// RootKey is the PEM-encoded root CA private key.
+	RootKey pem.PrivateKey
+
+	// IntermediateCert is the PEM-encoded intermediate certificate chain,
+	// if the Secret provided one. It is nil otherwise.
+	IntermediateCert pem.Certificate
+}
+
+// LoadAndValidate fetches the Secret named secretName in namespace and
+// validates that it contains a usable root CA: the certificate must be a
+// CA certificate, must not be expired, must not expire within
+// renewBefore, and its private key must match its public key. If the
+// Secret also provides an intermediate chain, every certificate in it
+// must parse and must not be expired.
+func LoadAndValidate(ctx context.Context, kubeClient kubernetes.Interface, namespace, secretName string, renewBefore time.Duration) (*CABundle, error) {
+	secret, err := kubeClient.CoreV1().Secrets(namespace).Get(ctx, secretName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("getting BYO CA secret %s/%s: %w", namespace, secretName, err)
+	}
+
+	crtPEM, ok := secret.Data[RootCertFileName]
+	if !ok {
+		return nil, &ValidationError{Reason: fmt.Sprintf("secret %s/%s is missing key %s", namespace, secretName, RootCertFileName)}
+	}
+	keyPEM, ok := secret.Data[RootKeyFileName]
+	if !ok {
+		return nil, &ValidationError{Reason: fmt.Sprintf("secret %s/%s is missing key %s", namespace, secretName, RootKeyFileName)}
+	}
+
+	if err := validate(crtPEM, keyPEM, renewBefore); err != nil {
+		return nil, err
+	}
+
+	intermediatePEM, ok := secret.Data[IntermediateCertFileName]
+	if ok {
+		if err := validateIntermediateChain(intermediatePEM); err != nil {
+			return nil, err
+		}
+	}
+
+	bundle := &CABundle{
+		RootCert: pem.Certificate(crtPEM),
+		RootKey:  pem.PrivateKey(keyPEM),
+	}
+	if ok {
+		bundle.IntermediateCert = pem.Certificate(intermediatePEM)
+	}
+	return bundle, nil
+}
+
+// validate checks that crtPEM/keyPEM form a usable, currently-valid CA
+// key pair.
+func validate(crtPEM, keyPEM []byte, renewBefore time.Duration) error {
+	tlsCert, err := tls.X509KeyPair(crtPEM, keyPEM)
+	if err != nil {
+		return &ValidationError{Reason: fmt.Sprintf("certificate and key do not match: %s", err)}
+	}
+
+	cert, err := x509.ParseCertificate(tlsCert.Certificate[0])
+	if err != nil {
+		return &ValidationError{Reason: fmt.Sprintf("could not parse certificate: %s", err)}
+	}
+
+	if !cert.IsCA {
+		return &ValidationError{Reason: "certificate is not a CA certificate"}
+	}
+
+	if time.Now().After(cert.NotAfter) {
+		return &ValidationError{Reason: fmt.Sprintf("certificate expired at %s", cert.NotAfter)}
+	}
+
+	if time.Until(cert.NotAfter) < renewBefore {
+		return &ValidationError{Reason: fmt.Sprintf("certificate expires at %s, within the configured RenewBeforeCertExpires window", cert.NotAfter)}
+	}
+
+	return nil
+}
+
+// validateIntermediateChain checks that every certificate PEM-encoded in
+// chainPEM parses and has not expired.
+func validateIntermediateChain(chainPEM []byte) error {
+	certs, err := certificate.DecodePEMCertificateChain(chainPEM)
+	if err != nil {
+		return &ValidationError{Reason: fmt.Sprintf("could not parse intermediate chain: %s", err)}
+	}
+	if len(certs) == 0 {
+		return &ValidationError{Reason: fmt.Sprintf("%s did not contain any certificates", IntermediateCertFileName)}
+	}
+
+	for _, cert := range certs {
+		if time.Now().After(cert.NotAfter) {
+			return &ValidationError{Reason: fmt.Sprintf("intermediate certificate %q expired at %s", cert.Subject.CommonName, cert.NotAfter)}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,140 @@
+package byo
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclient "k8s.io/client-go/kubernetes/fake"
+)
+
+func fabricateKeyPair(t *testing.T, isCA bool, notBefore, notAfter time.Time) (crtPEM, keyPEM []byte) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-root"},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		IsCA:                  isCA,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+
+	crtPEM = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM = pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return
+}
+
+func TestValidate(t *testing.T) {
+	renewBefore := 24 * time.Hour
+
+	t.Run("valid CA passes", func(t *testing.T) {
+		crtPEM, keyPEM := fabricateKeyPair(t, true, time.Now().Add(-time.Hour), time.Now().Add(365*24*time.Hour))
+		assert.NoError(t, validate(crtPEM, keyPEM, renewBefore))
+	})
+
+	t.Run("non-CA certificate is rejected", func(t *testing.T) {
+		crtPEM, keyPEM := fabricateKeyPair(t, false, time.Now().Add(-time.Hour), time.Now().Add(365*24*time.Hour))
+		err := validate(crtPEM, keyPEM, renewBefore)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "not a CA")
+	})
+
+	t.Run("expired certificate is rejected", func(t *testing.T) {
+		crtPEM, keyPEM := fabricateKeyPair(t, true, time.Now().Add(-48*time.Hour), time.Now().Add(-time.Hour))
+		err := validate(crtPEM, keyPEM, renewBefore)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "expired")
+	})
+
+	t.Run("certificate within the renewBefore window is rejected", func(t *testing.T) {
+		crtPEM, keyPEM := fabricateKeyPair(t, true, time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+		err := validate(crtPEM, keyPEM, renewBefore)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "RenewBeforeCertExpires")
+	})
+
+	t.Run("mismatched key and certificate are rejected", func(t *testing.T) {
+		crtPEM, _ := fabricateKeyPair(t, true, time.Now().Add(-time.Hour), time.Now().Add(365*24*time.Hour))
+		_, keyPEM := fabricateKeyPair(t, true, time.Now().Add(-time.Hour), time.Now().Add(365*24*time.Hour))
+		err := validate(crtPEM, keyPEM, renewBefore)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "do not match")
+	})
+}
+
+func TestLoadAndValidate(t *testing.T) {
+	renewBefore := 24 * time.Hour
+	crtPEM, keyPEM := fabricateKeyPair(t, true, time.Now().Add(-time.Hour), time.Now().Add(365*24*time.Hour))
+	intermediateCrtPEM, _ := fabricateKeyPair(t, true, time.Now().Add(-time.Hour), time.Now().Add(365*24*time.Hour))
+
+	t.Run("loads a root-only secret", func(t *testing.T) {
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "byo-ca", Namespace: "osm-system"},
+			Data: map[string][]byte{
+				RootCertFileName: crtPEM,
+				RootKeyFileName:  keyPEM,
+			},
+		}
+		kubeClient := testclient.NewSimpleClientset(secret)
+
+		bundle, err := LoadAndValidate(context.Background(), kubeClient, secret.Namespace, secret.Name, renewBefore)
+		assert.NoError(t, err)
+		assert.Equal(t, crtPEM, []byte(bundle.RootCert))
+		assert.Equal(t, keyPEM, []byte(bundle.RootKey))
+		assert.Nil(t, bundle.IntermediateCert)
+	})
+
+	t.Run("loads and returns a valid intermediate chain", func(t *testing.T) {
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "byo-ca", Namespace: "osm-system"},
+			Data: map[string][]byte{
+				RootCertFileName:         crtPEM,
+				RootKeyFileName:          keyPEM,
+				IntermediateCertFileName: intermediateCrtPEM,
+			},
+		}
+		kubeClient := testclient.NewSimpleClientset(secret)
+
+		bundle, err := LoadAndValidate(context.Background(), kubeClient, secret.Namespace, secret.Name, renewBefore)
+		assert.NoError(t, err)
+		assert.Equal(t, intermediateCrtPEM, []byte(bundle.IntermediateCert))
+	})
+
+	t.Run("rejects an expired intermediate chain", func(t *testing.T) {
+		expiredIntermediatePEM, _ := fabricateKeyPair(t, true, time.Now().Add(-48*time.Hour), time.Now().Add(-time.Hour))
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "byo-ca", Namespace: "osm-system"},
+			Data: map[string][]byte{
+				RootCertFileName:         crtPEM,
+				RootKeyFileName:          keyPEM,
+				IntermediateCertFileName: expiredIntermediatePEM,
+			},
+		}
+		kubeClient := testclient.NewSimpleClientset(secret)
+
+		_, err := LoadAndValidate(context.Background(), kubeClient, secret.Namespace, secret.Name, renewBefore)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "expired")
+	})
+}
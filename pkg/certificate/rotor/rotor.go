@@ -0,0 +1,126 @@
+// Package rotor implements the multi-stage state machine that drives a
+// MeshRootCertificate (MRC) safely through root CA rotation: issuing a new
+// root, rolling a combined trust bundle out to every proxy, cutting signing
+// over to the new root, retiring the old root, and finally marking the
+// rotation complete.
+//
+// The state machine never advances a stage until every Envoy proxy in the
+// mesh has acknowledged the trust bundle implied by the current stage, so a
+// rotation can be safely left running across multiple reconciles without
+// ever presenting a proxy with a root it has not yet been told to trust.
+package rotor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/openservicemesh/osm/pkg/apis/config/v1alpha2"
+)
+
+// AckChecker reports whether every proxy in the mesh has acknowledged the
+// most recently pushed SDS trust bundle for the given MeshRootCertificate.
+// It is satisfied by ads.TrustBundleAckTracker (pkg/envoy/ads), which
+// tracks the per-proxy ACKs recorded on it via RecordACK.
+type AckChecker interface {
+	// AllProxiesAcked returns true when every connected proxy has ACKed the
+	// trust bundle currently associated with mrcName.
+	AllProxiesAcked(ctx context.Context, mrcName string) (bool, error)
+
+	// Forget discards any ACK state tracked for mrcName. The rotor calls
+	// this once a rotation reaches MRCStageComplete, so the tracker does
+	// not hold onto per-proxy ACK state for MRCs it will never check again.
+	Forget(mrcName string)
+}
+
+// MRCClient is the subset of the generated MeshRootCertificate client that
+// the rotor needs to drive a rotation forward.
+type MRCClient interface {
+	// UpdateStatus persists the Status subresource of mrc.
+	UpdateStatus(ctx context.Context, mrc *v1alpha2.MeshRootCertificate) error
+
+	// Delete removes the MeshRootCertificate named name. It must tolerate
+	// name already being gone.
+	Delete(ctx context.Context, name string) error
+}
+
+// Rotor drives a single MeshRootCertificate through its rotation stages.
+type Rotor struct {
+	mrcClient  MRCClient
+	ackChecker AckChecker
+}
+
+// NewRotor returns a Rotor that persists status via mrcClient and gates
+// stage transitions on ackChecker.
+func NewRotor(mrcClient MRCClient, ackChecker AckChecker) *Rotor {
+	return &Rotor{
+		mrcClient:  mrcClient,
+		ackChecker: ackChecker,
+	}
+}
+
+// Reconcile advances mrc by at most one rotation stage. An MRC with no
+// stage yet is set to MRCStageIssued without an ACK check; otherwise it
+// is a no-op unless every proxy has ACKed the trust bundle implied by the
+// current stage, so it is safe to call repeatedly from a resync loop.
+func (r *Rotor) Reconcile(ctx context.Context, mrc *v1alpha2.MeshRootCertificate) error {
+	if mrc.Status.RotationStage == "" {
+		mrc.Status.State = v1alpha2.MRCStateActive
+		mrc.Status.RotationStage = v1alpha2.MRCStageIssued
+		return r.mrcClient.UpdateStatus(ctx, mrc)
+	}
+
+	next, ok := nextStage[mrc.Status.RotationStage]
+	if !ok {
+		return fmt.Errorf("unknown rotation stage %q for MeshRootCertificate %s/%s", mrc.Status.RotationStage, mrc.Namespace, mrc.Name)
+	}
+	if next == "" {
+		// Already in the terminal stage; nothing left to drive.
+		return nil
+	}
+
+	acked, err := r.ackChecker.AllProxiesAcked(ctx, mrc.Name)
+	if err != nil {
+		return fmt.Errorf("checking proxy ACKs for MeshRootCertificate %s/%s: %w", mrc.Namespace, mrc.Name, err)
+	}
+	if !acked {
+		log.Debug().Msgf("MeshRootCertificate %s/%s is waiting for proxies to ACK stage %s before advancing to %s", mrc.Namespace, mrc.Name, mrc.Status.RotationStage, next)
+		return nil
+	}
+
+	log.Info().Msgf("MeshRootCertificate %s/%s advancing rotation stage %s -> %s", mrc.Namespace, mrc.Name, mrc.Status.RotationStage, next)
+	mrc.Status.RotationStage = next
+	if next == v1alpha2.MRCStageComplete {
+		mrc.Status.State = v1alpha2.MRCStateComplete
+		if err := r.deletePrevious(ctx, mrc); err != nil {
+			return err
+		}
+		r.ackChecker.Forget(mrc.Name)
+	}
+	return r.mrcClient.UpdateStatus(ctx, mrc)
+}
+
+// deletePrevious deletes the MeshRootCertificate mrc is rotating out, if
+// any, now that mrc's rotation has reached MRCStageComplete.
+func (r *Rotor) deletePrevious(ctx context.Context, mrc *v1alpha2.MeshRootCertificate) error {
+	if mrc.Status.PreviousMRCName == "" {
+		return nil
+	}
+
+	log.Info().Msgf("MeshRootCertificate %s/%s completed rotation, deleting superseded MeshRootCertificate %s", mrc.Namespace, mrc.Name, mrc.Status.PreviousMRCName)
+	if err := r.mrcClient.Delete(ctx, mrc.Status.PreviousMRCName); err != nil {
+		return fmt.Errorf("deleting superseded MeshRootCertificate %s for %s/%s: %w", mrc.Status.PreviousMRCName, mrc.Namespace, mrc.Name, err)
+	}
+	return nil
+}
+
+// nextStage maps each rotation stage to the stage that follows it. The
+// terminal stage maps to the empty string.
+var nextStage = map[string]string{
+	v1alpha2.MRCStageIssued:         v1alpha2.MRCStageIssuingRollout,
+	v1alpha2.MRCStageIssuingRollout: v1alpha2.MRCStageIssuingActive,
+	v1alpha2.MRCStageIssuingActive:  v1alpha2.MRCStageRetiring,
+	v1alpha2.MRCStageRetiring:       v1alpha2.MRCStageComplete,
+	v1alpha2.MRCStageComplete:       "",
+}
@@ -0,0 +1,188 @@
+package rotor
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openservicemesh/osm/pkg/apis/config/v1alpha2"
+)
+
+type fakeMRCClient struct {
+	updated []*v1alpha2.MeshRootCertificate
+	deleted []string
+	err     error
+}
+
+func (f *fakeMRCClient) UpdateStatus(_ context.Context, mrc *v1alpha2.MeshRootCertificate) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.updated = append(f.updated, mrc)
+	return nil
+}
+
+func (f *fakeMRCClient) Delete(_ context.Context, name string) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.deleted = append(f.deleted, name)
+	return nil
+}
+
+type fakeAckChecker struct {
+	acked     bool
+	err       error
+	forgotten []string
+}
+
+func (f *fakeAckChecker) AllProxiesAcked(_ context.Context, _ string) (bool, error) {
+	return f.acked, f.err
+}
+
+func (f *fakeAckChecker) Forget(mrcName string) {
+	f.forgotten = append(f.forgotten, mrcName)
+}
+
+func TestReconcileNewMRCStartsAtIssued(t *testing.T) {
+	mrcClient := &fakeMRCClient{}
+	r := NewRotor(mrcClient, &fakeAckChecker{acked: true})
+
+	mrc := &v1alpha2.MeshRootCertificate{}
+	assert.NoError(t, r.Reconcile(context.Background(), mrc))
+
+	assert.Equal(t, v1alpha2.MRCStateActive, mrc.Status.State)
+	assert.Equal(t, v1alpha2.MRCStageIssued, mrc.Status.RotationStage)
+	assert.Len(t, mrcClient.updated, 1)
+}
+
+func TestReconcileStageTransitions(t *testing.T) {
+	testCases := []struct {
+		name          string
+		currentStage  string
+		expectedStage string
+	}{
+		{"issued -> issuingRollout", v1alpha2.MRCStageIssued, v1alpha2.MRCStageIssuingRollout},
+		{"issuingRollout -> issuingActive", v1alpha2.MRCStageIssuingRollout, v1alpha2.MRCStageIssuingActive},
+		{"issuingActive -> retiring", v1alpha2.MRCStageIssuingActive, v1alpha2.MRCStageRetiring},
+		{"retiring -> complete", v1alpha2.MRCStageRetiring, v1alpha2.MRCStageComplete},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			mrcClient := &fakeMRCClient{}
+			r := NewRotor(mrcClient, &fakeAckChecker{acked: true})
+
+			mrc := &v1alpha2.MeshRootCertificate{
+				Status: v1alpha2.MeshRootCertificateStatus{
+					State:         v1alpha2.MRCStateActive,
+					RotationStage: tc.currentStage,
+				},
+			}
+
+			assert.NoError(t, r.Reconcile(context.Background(), mrc))
+			assert.Equal(t, tc.expectedStage, mrc.Status.RotationStage)
+			assert.Len(t, mrcClient.updated, 1)
+		})
+	}
+}
+
+func TestReconcileDoesNotAdvanceWithoutAcks(t *testing.T) {
+	mrcClient := &fakeMRCClient{}
+	r := NewRotor(mrcClient, &fakeAckChecker{acked: false})
+
+	mrc := &v1alpha2.MeshRootCertificate{
+		Status: v1alpha2.MeshRootCertificateStatus{
+			State:         v1alpha2.MRCStateActive,
+			RotationStage: v1alpha2.MRCStageIssued,
+		},
+	}
+
+	assert.NoError(t, r.Reconcile(context.Background(), mrc))
+	assert.Equal(t, v1alpha2.MRCStageIssued, mrc.Status.RotationStage, "stage must not advance without every proxy acking")
+	assert.Empty(t, mrcClient.updated, "status must not be persisted when no transition occurred")
+}
+
+func TestReconcileSurfacesAckCheckerError(t *testing.T) {
+	mrcClient := &fakeMRCClient{}
+	r := NewRotor(mrcClient, &fakeAckChecker{err: errors.New("xds server unavailable")})
+
+	mrc := &v1alpha2.MeshRootCertificate{
+		Status: v1alpha2.MeshRootCertificateStatus{
+			State:         v1alpha2.MRCStateActive,
+			RotationStage: v1alpha2.MRCStageIssued,
+		},
+	}
+
+	assert.Error(t, r.Reconcile(context.Background(), mrc))
+}
+
+func TestReconcileTerminalStageIsNoOp(t *testing.T) {
+	mrcClient := &fakeMRCClient{}
+	r := NewRotor(mrcClient, &fakeAckChecker{acked: true})
+
+	mrc := &v1alpha2.MeshRootCertificate{
+		Status: v1alpha2.MeshRootCertificateStatus{
+			State:         v1alpha2.MRCStateComplete,
+			RotationStage: v1alpha2.MRCStageComplete,
+		},
+	}
+
+	assert.NoError(t, r.Reconcile(context.Background(), mrc))
+	assert.Empty(t, mrcClient.updated)
+	assert.Empty(t, mrcClient.deleted)
+}
+
+func TestReconcileUnknownStageErrors(t *testing.T) {
+	mrcClient := &fakeMRCClient{}
+	r := NewRotor(mrcClient, &fakeAckChecker{acked: true})
+
+	mrc := &v1alpha2.MeshRootCertificate{
+		Status: v1alpha2.MeshRootCertificateStatus{
+			State:         v1alpha2.MRCStateActive,
+			RotationStage: "bogus",
+		},
+	}
+
+	assert.Error(t, r.Reconcile(context.Background(), mrc))
+}
+
+func TestReconcileCompleteDeletesPreviousMRC(t *testing.T) {
+	mrcClient := &fakeMRCClient{}
+	ackChecker := &fakeAckChecker{acked: true}
+	r := NewRotor(mrcClient, ackChecker)
+
+	mrc := &v1alpha2.MeshRootCertificate{
+		ObjectMeta: metav1.ObjectMeta{Name: "new-root"},
+		Status: v1alpha2.MeshRootCertificateStatus{
+			State:           v1alpha2.MRCStateActive,
+			RotationStage:   v1alpha2.MRCStageRetiring,
+			PreviousMRCName: "old-root",
+		},
+	}
+
+	assert.NoError(t, r.Reconcile(context.Background(), mrc))
+	assert.Equal(t, v1alpha2.MRCStageComplete, mrc.Status.RotationStage)
+	assert.Equal(t, v1alpha2.MRCStateComplete, mrc.Status.State)
+	assert.Equal(t, []string{"old-root"}, mrcClient.deleted)
+	assert.Equal(t, []string{"new-root"}, ackChecker.forgotten, "completing a rotation must forget its own tracked ACKs")
+}
+
+func TestReconcileCompleteWithNoPreviousMRCDeletesNothing(t *testing.T) {
+	mrcClient := &fakeMRCClient{}
+	r := NewRotor(mrcClient, &fakeAckChecker{acked: true})
+
+	mrc := &v1alpha2.MeshRootCertificate{
+		Status: v1alpha2.MeshRootCertificateStatus{
+			State:         v1alpha2.MRCStateActive,
+			RotationStage: v1alpha2.MRCStageRetiring,
+		},
+	}
+
+	assert.NoError(t, r.Reconcile(context.Background(), mrc))
+	assert.Empty(t, mrcClient.deleted)
+}
@@ -0,0 +1,62 @@
+package certificate
+
+import (
+	"time"
+
+	"github.com/openservicemesh/osm/pkg/certificate/pem"
+)
+
+// CommonName is the Subject Common Name from a x509 certificate.
+type CommonName string
+
+// String returns the CommonName as a string.
+func (cn CommonName) String() string {
+	return string(cn)
+}
+
+// SerialNumber is the Serial Number of a x509 certificate.
+type SerialNumber string
+
+// String returns the SerialNumber as a string.
+func (sn SerialNumber) String() string {
+	return string(sn)
+}
+
+// Certificate represents an x509 certificate issued to a proxy, a
+// service, or the mesh root itself.
+type Certificate struct {
+	// CommonName is the Subject Common Name of the certificate.
+	CommonName CommonName
+
+	// SerialNumber is the Serial Number of the certificate.
+	SerialNumber SerialNumber
+
+	// CertChain is the PEM-encoded certificate chain.
+	CertChain pem.Certificate
+
+	// PrivateKey is the PEM-encoded private key of the certificate.
+	PrivateKey pem.PrivateKey
+
+	// IssuingCA is the PEM-encoded root certificate that signed this
+	// certificate.
+	IssuingCA pem.RootCertificate
+
+	// NotBefore is the time at which the certificate becomes valid.
+	NotBefore time.Time
+
+	// Expiration is the time at which the certificate expires.
+	Expiration time.Time
+
+	// RotatePercentage overrides DefaultRotatePercentage for this
+	// certificate, if non-zero. Different certificate types (root,
+	// intermediate, xDS leaf, SDS leaf) can set this to rotate earlier or
+	// later in their lifetime than the default.
+	//
+	// Nothing in this tree sets RotatePercentage yet: there is no
+	// MeshConfig type here to make DefaultRotatePercentage configurable
+	// from, nor any cert-type-aware issuance path to set a per-type value
+	// on the Certificate it builds. Every certificate therefore still
+	// rotates at DefaultRotatePercentage in practice; wiring either of
+	// those in is left for whoever adds that issuance path.
+	RotatePercentage float64
+}
@@ -0,0 +1,83 @@
+// Package ads contains the pieces of the xDS Aggregated Discovery Service
+// server that the certificate rotation state machine (pkg/certificate/rotor)
+// needs in order to gate trust bundle rollout on proxy ACKs.
+package ads
+
+import (
+	"context"
+	"sync"
+)
+
+// ConnectedProxies reports the set of proxies currently connected to the
+// ADS server. It is satisfied by the ADS server's proxy registry.
+type ConnectedProxies interface {
+	// ListConnectedProxyUUIDs returns the UUIDs of every proxy with an
+	// open xDS stream.
+	ListConnectedProxyUUIDs() []string
+}
+
+// TrustBundleAckTracker implements rotor.AckChecker by recording, per
+// MeshRootCertificate, which connected proxies have ACKed the SDS
+// resources built from that MRC's trust bundle.
+//
+// RecordACK is meant to be called by the SDS response handler whenever it
+// processes a DiscoveryRequest acknowledging the trust bundle it most
+// recently sent. That handler does not exist in this tree yet (there is no
+// ADS/SDS server here at all, only the pieces pkg/certificate/rotor needs),
+// so nothing calls RecordACK today: acked stays empty, and AllProxiesAcked
+// will report false for any mesh with a connected proxy. Wiring this up
+// requires adding that call from wherever the real SDS DiscoveryRequest
+// handler lives.
+type TrustBundleAckTracker struct {
+	proxies ConnectedProxies
+
+	mu    sync.Mutex
+	acked map[string]map[string]struct{} // mrcName -> set of proxy UUIDs
+}
+
+// NewTrustBundleAckTracker returns a TrustBundleAckTracker that consults
+// proxies to determine the set of proxies an MRC's rollout must reach.
+func NewTrustBundleAckTracker(proxies ConnectedProxies) *TrustBundleAckTracker {
+	return &TrustBundleAckTracker{
+		proxies: proxies,
+		acked:   make(map[string]map[string]struct{}),
+	}
+}
+
+// RecordACK marks proxyUUID as having acknowledged the trust bundle
+// derived from the MeshRootCertificate named mrcName.
+func (t *TrustBundleAckTracker) RecordACK(mrcName, proxyUUID string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.acked[mrcName] == nil {
+		t.acked[mrcName] = make(map[string]struct{})
+	}
+	t.acked[mrcName][proxyUUID] = struct{}{}
+}
+
+// Forget discards tracked ACKs for mrcName, e.g. once its rotation has
+// completed and it is no longer of interest.
+func (t *TrustBundleAckTracker) Forget(mrcName string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.acked, mrcName)
+}
+
+// AllProxiesAcked returns true when every currently connected proxy has
+// ACKed the trust bundle associated with mrcName. A mesh with no
+// connected proxies trivially satisfies this.
+func (t *TrustBundleAckTracker) AllProxiesAcked(_ context.Context, mrcName string) (bool, error) {
+	connected := t.proxies.ListConnectedProxyUUIDs()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	acked := t.acked[mrcName]
+	for _, uuid := range connected {
+		if _, ok := acked[uuid]; !ok {
+			return false, nil
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,59 @@
+package ads
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeConnectedProxies struct {
+	uuids []string
+}
+
+func (f *fakeConnectedProxies) ListConnectedProxyUUIDs() []string {
+	return f.uuids
+}
+
+func TestTrustBundleAckTracker(t *testing.T) {
+	proxies := &fakeConnectedProxies{uuids: []string{"proxy-1", "proxy-2"}}
+	tracker := NewTrustBundleAckTracker(proxies)
+
+	acked, err := tracker.AllProxiesAcked(context.Background(), "mrc-1")
+	assert.NoError(t, err)
+	assert.False(t, acked, "no proxy has acked yet")
+
+	tracker.RecordACK("mrc-1", "proxy-1")
+	acked, err = tracker.AllProxiesAcked(context.Background(), "mrc-1")
+	assert.NoError(t, err)
+	assert.False(t, acked, "one of two proxies has acked")
+
+	tracker.RecordACK("mrc-1", "proxy-2")
+	acked, err = tracker.AllProxiesAcked(context.Background(), "mrc-1")
+	assert.NoError(t, err)
+	assert.True(t, acked, "both proxies have acked")
+
+	acked, err = tracker.AllProxiesAcked(context.Background(), "mrc-2")
+	assert.NoError(t, err)
+	assert.False(t, acked, "acks for a different MRC must not leak across MRCs")
+}
+
+func TestTrustBundleAckTrackerNoConnectedProxies(t *testing.T) {
+	tracker := NewTrustBundleAckTracker(&fakeConnectedProxies{})
+
+	acked, err := tracker.AllProxiesAcked(context.Background(), "mrc-1")
+	assert.NoError(t, err)
+	assert.True(t, acked, "an empty mesh trivially satisfies the ack check")
+}
+
+func TestTrustBundleAckTrackerForget(t *testing.T) {
+	proxies := &fakeConnectedProxies{uuids: []string{"proxy-1"}}
+	tracker := NewTrustBundleAckTracker(proxies)
+
+	tracker.RecordACK("mrc-1", "proxy-1")
+	tracker.Forget("mrc-1")
+
+	acked, err := tracker.AllProxiesAcked(context.Background(), "mrc-1")
+	assert.NoError(t, err)
+	assert.False(t, acked, "forgetting an MRC clears its recorded acks")
+}
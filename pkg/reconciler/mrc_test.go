@@ -0,0 +1,100 @@
+package reconciler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openservicemesh/osm/pkg/apis/config/v1alpha2"
+	"github.com/openservicemesh/osm/pkg/certificate/rotor"
+	"github.com/openservicemesh/osm/pkg/envoy/ads"
+)
+
+type fakeMRCLister struct {
+	mrcs []*v1alpha2.MeshRootCertificate
+	err  error
+}
+
+func (f *fakeMRCLister) List() ([]*v1alpha2.MeshRootCertificate, error) {
+	return f.mrcs, f.err
+}
+
+type fakeMRCClient struct {
+	reconciled []string
+}
+
+func (f *fakeMRCClient) UpdateStatus(_ context.Context, mrc *v1alpha2.MeshRootCertificate) error {
+	f.reconciled = append(f.reconciled, mrc.Name)
+	return nil
+}
+
+func (f *fakeMRCClient) Delete(_ context.Context, _ string) error {
+	return nil
+}
+
+type alwaysAckedChecker struct{}
+
+func (alwaysAckedChecker) AllProxiesAcked(_ context.Context, _ string) (bool, error) {
+	return true, nil
+}
+
+func (alwaysAckedChecker) Forget(_ string) {}
+
+func mrcWithState(name, state, stage string) *v1alpha2.MeshRootCertificate {
+	return &v1alpha2.MeshRootCertificate{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: v1alpha2.MeshRootCertificateStatus{
+			State:         state,
+			RotationStage: stage,
+		},
+	}
+}
+
+func TestReconcileAllSkipsTerminalStates(t *testing.T) {
+	active := mrcWithState("active-mrc", v1alpha2.MRCStateActive, v1alpha2.MRCStageIssued)
+	complete := mrcWithState("complete-mrc", v1alpha2.MRCStateComplete, v1alpha2.MRCStageComplete)
+	errored := mrcWithState("errored-mrc", v1alpha2.MRCStateError, v1alpha2.MRCStageIssuingRollout)
+
+	lister := &fakeMRCLister{mrcs: []*v1alpha2.MeshRootCertificate{active, complete, errored}}
+	mrcClient := &fakeMRCClient{}
+	r := rotor.NewRotor(mrcClient, alwaysAckedChecker{})
+	c := NewMRCReconciler(lister, r, make(chan struct{}))
+
+	c.reconcileAll(context.Background())
+
+	assert.Equal(t, []string{"active-mrc"}, mrcClient.reconciled, "only the non-terminal MRC should be reconciled")
+}
+
+func TestReconcileAllToleratesListerError(t *testing.T) {
+	lister := &fakeMRCLister{err: errors.New("informer not synced")}
+	mrcClient := &fakeMRCClient{}
+	r := rotor.NewRotor(mrcClient, alwaysAckedChecker{})
+	c := NewMRCReconciler(lister, r, make(chan struct{}))
+
+	assert.NotPanics(t, func() { c.reconcileAll(context.Background()) })
+	assert.Empty(t, mrcClient.reconciled)
+}
+
+type fakeConnectedProxies struct{}
+
+func (fakeConnectedProxies) ListConnectedProxyUUIDs() []string { return nil }
+
+func TestNewDefaultMRCReconcilerWiresAckTracker(t *testing.T) {
+	lister := &fakeMRCLister{mrcs: []*v1alpha2.MeshRootCertificate{
+		mrcWithState("mrc-1", v1alpha2.MRCStateActive, v1alpha2.MRCStageIssued),
+	}}
+	mrcClient := &fakeMRCClient{}
+
+	c := NewDefaultMRCReconciler(lister, mrcClient, fakeConnectedProxies{}, make(chan struct{}))
+	c.reconcileAll(context.Background())
+
+	// With no connected proxies, AllProxiesAcked is trivially satisfied by
+	// ads.TrustBundleAckTracker, so the stage should advance.
+	assert.Equal(t, []string{"mrc-1"}, mrcClient.reconciled)
+	assert.Equal(t, v1alpha2.MRCStageIssuingRollout, lister.mrcs[0].Status.RotationStage)
+}
+
+var _ ads.ConnectedProxies = fakeConnectedProxies{}
@@ -0,0 +1,161 @@
+package reconciler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+
+	osmKube "github.com/openservicemesh/osm/pkg/kubernetes"
+)
+
+// TrustBundleConfigMapName is the name of the ConfigMap the trust bundle
+// publisher writes into every monitored namespace.
+const TrustBundleConfigMapName = "osm-ca-bundle"
+
+// TrustBundleConfigMapRootCertKey is the Data key under which the root CA
+// PEM bundle is stored.
+const TrustBundleConfigMapRootCertKey = "ca.crt"
+
+// TrustBundleProvider returns the PEM bundle of root certificates that are
+// currently trusted by the mesh. During a root rotation this is expected
+// to return the union of the old and new roots for the IssuingRollout and
+// IssuingActive stages, and only the new root otherwise.
+type TrustBundleProvider interface {
+	GetTrustedCAPEMs(ctx context.Context) ([]byte, error)
+}
+
+// TrustBundlePublisher publishes the mesh's current trust bundle as a
+// ConfigMap into every monitored namespace, so that non-Envoy workloads
+// (Prometheus, admin dashboards, cert-manager CAIssuers, ingress) can
+// consume the mesh root without talking to the control plane.
+type TrustBundlePublisher struct {
+	kubeClient     kubernetes.Interface
+	kubeController osmKube.Controller
+	trustBundle    TrustBundleProvider
+}
+
+// NewTrustBundlePublisher returns a TrustBundlePublisher that writes the
+// osm-ca-bundle ConfigMap into every namespace kubeController considers
+// monitored. Cleanup when a namespace leaves the mesh is handled entirely
+// by reconcile's own delete-on-unmonitor pass below; the ConfigMap is
+// deliberately not given an OwnerReference to the OSM controller
+// Deployment, since that Deployment lives in a different namespace and
+// Kubernetes garbage collection does not honor cross-namespace owners.
+func NewTrustBundlePublisher(kubeClient kubernetes.Interface, kubeController osmKube.Controller, trustBundle TrustBundleProvider) *TrustBundlePublisher {
+	return &TrustBundlePublisher{
+		kubeClient:     kubeClient,
+		kubeController: kubeController,
+		trustBundle:    trustBundle,
+	}
+}
+
+// Run reacts to namespace monitoring changes until stop is closed,
+// reconciling the set of published ConfigMaps each time. It also
+// resyncs on a timer: the publisher has no announcement channel for the
+// MeshRootCertificate rotation state machine (pkg/certificate/rotor), so
+// a stage transition (e.g. entering IssuingRollout, where the published
+// bundle must become the union of the old and new roots) would otherwise
+// go unnoticed until the next namespace change.
+func (p *TrustBundlePublisher) Run(ctx context.Context, stop <-chan struct{}) {
+	announcements := p.kubeController.GetAnnouncementsChannel(osmKube.Namespaces)
+
+	ticker := time.NewTicker(resyncInterval)
+	defer ticker.Stop()
+
+	// Publish the initial state before waiting on any announcement or tick.
+	if err := p.reconcile(ctx); err != nil {
+		log.Error().Err(err).Msg("error publishing initial trust bundle ConfigMaps")
+	}
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-announcements:
+			if err := p.reconcile(ctx); err != nil {
+				log.Error().Err(err).Msg("error reconciling trust bundle ConfigMaps")
+			}
+		case <-ticker.C:
+			if err := p.reconcile(ctx); err != nil {
+				log.Error().Err(err).Msg("error resyncing trust bundle ConfigMaps")
+			}
+		}
+	}
+}
+
+// reconcile writes the trust bundle ConfigMap into every currently
+// monitored namespace and removes it from namespaces holding a copy that
+// are no longer monitored.
+func (p *TrustBundlePublisher) reconcile(ctx context.Context) error {
+	caPEM, err := p.trustBundle.GetTrustedCAPEMs(ctx)
+	if err != nil {
+		return fmt.Errorf("getting current trust bundle: %w", err)
+	}
+
+	monitored, err := p.kubeController.ListMonitoredNamespaces()
+	if err != nil {
+		return fmt.Errorf("listing monitored namespaces: %w", err)
+	}
+	monitoredSet := sets.NewString(monitored...)
+
+	published, err := p.kubeClient.CoreV1().ConfigMaps(corev1.NamespaceAll).List(ctx, metav1.ListOptions{
+		FieldSelector: "metadata.name=" + TrustBundleConfigMapName,
+	})
+	if err != nil {
+		return fmt.Errorf("listing published trust bundle ConfigMaps: %w", err)
+	}
+
+	for _, ns := range monitored {
+		if err := p.publishToNamespace(ctx, ns, caPEM); err != nil {
+			log.Error().Err(err).Msgf("error publishing trust bundle ConfigMap to namespace %s", ns)
+		}
+	}
+
+	for _, cm := range published.Items {
+		if monitoredSet.Has(cm.Namespace) {
+			continue
+		}
+		if err := p.kubeClient.CoreV1().ConfigMaps(cm.Namespace).Delete(ctx, TrustBundleConfigMapName, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			log.Error().Err(err).Msgf("error deleting trust bundle ConfigMap from namespace %s", cm.Namespace)
+		}
+	}
+
+	return nil
+}
+
+// publishToNamespace creates or updates the trust bundle ConfigMap in ns.
+func (p *TrustBundlePublisher) publishToNamespace(ctx context.Context, ns string, caPEM []byte) error {
+	desired := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      TrustBundleConfigMapName,
+			Namespace: ns,
+		},
+		Data: map[string]string{
+			TrustBundleConfigMapRootCertKey: string(caPEM),
+		},
+	}
+
+	existing, err := p.kubeClient.CoreV1().ConfigMaps(ns).Get(ctx, TrustBundleConfigMapName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		_, err = p.kubeClient.CoreV1().ConfigMaps(ns).Create(ctx, desired, metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	if existing.Data[TrustBundleConfigMapRootCertKey] == string(caPEM) {
+		return nil
+	}
+
+	existing.Data = desired.Data
+	_, err = p.kubeClient.CoreV1().ConfigMaps(ns).Update(ctx, existing, metav1.UpdateOptions{})
+	return err
+}
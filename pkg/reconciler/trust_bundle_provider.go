@@ -0,0 +1,142 @@
+package reconciler
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/openservicemesh/osm/pkg/apis/config/v1alpha2"
+	"github.com/openservicemesh/osm/pkg/certificate/providers/byo"
+)
+
+// RootCertResolver returns the PEM-encoded root certificate a
+// MeshRootCertificate's provider currently holds.
+type RootCertResolver interface {
+	GetRootCert(ctx context.Context, mrc *v1alpha2.MeshRootCertificate) ([]byte, error)
+}
+
+// RotationAwareTrustBundle implements TrustBundleProvider by consulting the
+// MeshRootCertificate rotation state: during MRCStageIssuingRollout and
+// MRCStageIssuingActive, a rotating MRC's trust bundle must include both
+// its own root and the previous root it is replacing, so that proxies
+// trust whichever of the two signed the certificate they were last
+// issued. A replacing MRC's own root is left out while it is still in
+// MRCStageIssued, and the previous root is left out once the replacing
+// MRC reaches MRCStageRetiring or MRCStageComplete. Every other MRC not in
+// MRCStateError contributes its own root.
+type RotationAwareTrustBundle struct {
+	lister   MRCLister
+	resolver RootCertResolver
+}
+
+// NewRotationAwareTrustBundle returns a RotationAwareTrustBundle that reads
+// MeshRootCertificates from lister and resolves each one's root PEM via
+// resolver.
+func NewRotationAwareTrustBundle(lister MRCLister, resolver RootCertResolver) *RotationAwareTrustBundle {
+	return &RotationAwareTrustBundle{
+		lister:   lister,
+		resolver: resolver,
+	}
+}
+
+// GetTrustedCAPEMs returns the concatenation of every currently trusted
+// root's PEM bundle.
+func (b *RotationAwareTrustBundle) GetTrustedCAPEMs(ctx context.Context) ([]byte, error) {
+	mrcs, err := b.lister.List()
+	if err != nil {
+		return nil, fmt.Errorf("listing MeshRootCertificates: %w", err)
+	}
+
+	byName := make(map[string]*v1alpha2.MeshRootCertificate, len(mrcs))
+	retired := make(map[string]struct{})
+	for _, mrc := range mrcs {
+		byName[mrc.Name] = mrc
+		if mrc.Status.PreviousMRCName != "" && (mrc.Status.RotationStage == v1alpha2.MRCStageRetiring || mrc.Status.RotationStage == v1alpha2.MRCStageComplete) {
+			retired[mrc.Status.PreviousMRCName] = struct{}{}
+		}
+	}
+
+	var bundle []byte
+	seen := make(map[string]struct{})
+	for _, mrc := range mrcs {
+		if mrc.Status.State == v1alpha2.MRCStateError {
+			continue
+		}
+		if _, ok := retired[mrc.Name]; ok {
+			continue
+		}
+		if mrc.Status.PreviousMRCName != "" && (mrc.Status.RotationStage == "" || mrc.Status.RotationStage == v1alpha2.MRCStageIssued) {
+			continue
+		}
+
+		pem, err := b.resolveOnce(ctx, mrc, seen)
+		if err != nil {
+			return nil, err
+		}
+		bundle = append(bundle, pem...)
+
+		rollingOut := mrc.Status.RotationStage == v1alpha2.MRCStageIssuingRollout || mrc.Status.RotationStage == v1alpha2.MRCStageIssuingActive
+		if !rollingOut || mrc.Status.PreviousMRCName == "" {
+			continue
+		}
+
+		previous, ok := byName[mrc.Status.PreviousMRCName]
+		if !ok {
+			// The previous MRC is already gone (e.g. its own rotation
+			// completed and it was deleted); nothing left to union.
+			continue
+		}
+		pem, err = b.resolveOnce(ctx, previous, seen)
+		if err != nil {
+			return nil, err
+		}
+		bundle = append(bundle, pem...)
+	}
+
+	return bundle, nil
+}
+
+// resolveOnce resolves mrc's root PEM, skipping it if it has already been
+// added to the bundle by an earlier iteration.
+func (b *RotationAwareTrustBundle) resolveOnce(ctx context.Context, mrc *v1alpha2.MeshRootCertificate, seen map[string]struct{}) ([]byte, error) {
+	if _, ok := seen[mrc.Name]; ok {
+		return nil, nil
+	}
+	seen[mrc.Name] = struct{}{}
+
+	root, err := b.resolver.GetRootCert(ctx, mrc)
+	if err != nil {
+		return nil, fmt.Errorf("getting root certificate for MeshRootCertificate %s/%s: %w", mrc.Namespace, mrc.Name, err)
+	}
+	return root, nil
+}
+
+// byoRootCertResolver implements RootCertResolver for the byo provider
+// only. Tresor, Vault, and CertManager provider support does not exist
+// anywhere in this tree, so resolving an MRC that uses one of them fails
+// with an explicit error rather than silently returning an empty root.
+type byoRootCertResolver struct {
+	kubeClient kubernetes.Interface
+}
+
+// NewBYORootCertResolver returns a RootCertResolver backed by kubeClient,
+// usable with MeshRootCertificates that set the byo provider.
+func NewBYORootCertResolver(kubeClient kubernetes.Interface) RootCertResolver {
+	return &byoRootCertResolver{kubeClient: kubeClient}
+}
+
+// GetRootCert loads and validates mrc's byo Secret and returns its root
+// certificate PEM.
+func (r *byoRootCertResolver) GetRootCert(ctx context.Context, mrc *v1alpha2.MeshRootCertificate) ([]byte, error) {
+	byoSpec := mrc.Spec.Provider.BYO
+	if byoSpec == nil {
+		return nil, fmt.Errorf("MeshRootCertificate %s/%s: only the byo provider is supported by RotationAwareTrustBundle in this build", mrc.Namespace, mrc.Name)
+	}
+
+	bundle, err := byo.LoadAndValidate(ctx, r.kubeClient, mrc.Namespace, byoSpec.SecretName, 0)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(bundle.RootCert), nil
+}
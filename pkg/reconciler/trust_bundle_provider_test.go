@@ -0,0 +1,86 @@
+package reconciler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openservicemesh/osm/pkg/apis/config/v1alpha2"
+)
+
+type fakeRootCertResolver struct {
+	pems map[string][]byte
+	err  error
+}
+
+func (f *fakeRootCertResolver) GetRootCert(_ context.Context, mrc *v1alpha2.MeshRootCertificate) ([]byte, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return f.pems[mrc.Name], nil
+}
+
+func TestRotationAwareTrustBundleOutsideRollout(t *testing.T) {
+	mrc := mrcWithState("root-1", v1alpha2.MRCStateActive, v1alpha2.MRCStageIssued)
+	lister := &fakeMRCLister{mrcs: []*v1alpha2.MeshRootCertificate{mrc}}
+	resolver := &fakeRootCertResolver{pems: map[string][]byte{"root-1": []byte("root-1-pem")}}
+
+	bundle := NewRotationAwareTrustBundle(lister, resolver)
+	pems, err := bundle.GetTrustedCAPEMs(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("root-1-pem"), pems)
+}
+
+func TestRotationAwareTrustBundleUnionsDuringRollout(t *testing.T) {
+	newRoot := mrcWithState("new-root", v1alpha2.MRCStateActive, v1alpha2.MRCStageIssuingRollout)
+	newRoot.Status.PreviousMRCName = "old-root"
+	oldRoot := mrcWithState("old-root", v1alpha2.MRCStateActive, v1alpha2.MRCStageRetiring)
+
+	lister := &fakeMRCLister{mrcs: []*v1alpha2.MeshRootCertificate{newRoot, oldRoot}}
+	resolver := &fakeRootCertResolver{pems: map[string][]byte{
+		"new-root": []byte("new-pem"),
+		"old-root": []byte("old-pem"),
+	}}
+
+	bundle := NewRotationAwareTrustBundle(lister, resolver)
+	pems, err := bundle.GetTrustedCAPEMs(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("new-pemold-pem"), pems)
+}
+
+func TestRotationAwareTrustBundleSkipsErroredMRCs(t *testing.T) {
+	errored := mrcWithState("bad-root", v1alpha2.MRCStateError, v1alpha2.MRCStageIssued)
+	lister := &fakeMRCLister{mrcs: []*v1alpha2.MeshRootCertificate{errored}}
+	resolver := &fakeRootCertResolver{pems: map[string][]byte{"bad-root": []byte("should-not-appear")}}
+
+	bundle := NewRotationAwareTrustBundle(lister, resolver)
+	pems, err := bundle.GetTrustedCAPEMs(context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, pems)
+}
+
+func TestRotationAwareTrustBundlePropagatesResolverError(t *testing.T) {
+	mrc := mrcWithState("root-1", v1alpha2.MRCStateActive, v1alpha2.MRCStageIssued)
+	lister := &fakeMRCLister{mrcs: []*v1alpha2.MeshRootCertificate{mrc}}
+	resolver := &fakeRootCertResolver{err: errors.New("secret not found")}
+
+	bundle := NewRotationAwareTrustBundle(lister, resolver)
+	_, err := bundle.GetTrustedCAPEMs(context.Background())
+	assert.Error(t, err)
+}
+
+func TestBYORootCertResolverRejectsNonBYOProvider(t *testing.T) {
+	mrc := &v1alpha2.MeshRootCertificate{
+		ObjectMeta: metav1.ObjectMeta{Name: "mrc", Namespace: "osm-system"},
+		Spec: v1alpha2.MeshRootCertificateSpec{
+			Provider: v1alpha2.ProviderSpec{Tresor: &v1alpha2.TresorProviderSpec{}},
+		},
+	}
+
+	resolver := NewBYORootCertResolver(nil)
+	_, err := resolver.GetRootCert(context.Background(), mrc)
+	assert.Error(t, err)
+}
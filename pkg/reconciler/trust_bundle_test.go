@@ -0,0 +1,117 @@
+package reconciler
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclient "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/openservicemesh/osm/pkg/constants"
+	osmKube "github.com/openservicemesh/osm/pkg/kubernetes"
+)
+
+const (
+	testMeshName          = "mesh"
+	nsInformerSyncTimeout = 3 * time.Second
+)
+
+type fakeTrustBundle struct {
+	pem []byte
+}
+
+func (f *fakeTrustBundle) GetTrustedCAPEMs(_ context.Context) ([]byte, error) {
+	return f.pem, nil
+}
+
+func TestTrustBundlePublisherReconcile(t *testing.T) {
+	kubeClient := testclient.NewSimpleClientset()
+	stop := make(chan struct{})
+	defer close(stop)
+
+	kubeController, err := osmKube.NewKubernetesController(kubeClient, testMeshName, stop)
+	assert.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		ns := corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   fmt.Sprintf("monitored-%d", i),
+				Labels: map[string]string{constants.OSMKubeResourceMonitorAnnotation: testMeshName},
+			},
+		}
+		_, err := kubeClient.CoreV1().Namespaces().Create(context.TODO(), &ns, metav1.CreateOptions{})
+		assert.NoError(t, err)
+	}
+	_, err = kubeClient.CoreV1().Namespaces().Create(context.TODO(), &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "unmonitored"},
+	}, metav1.CreateOptions{})
+	assert.NoError(t, err)
+
+	trustBundle := &fakeTrustBundle{pem: []byte("root-ca-pem")}
+	publisher := NewTrustBundlePublisher(kubeClient, kubeController, trustBundle)
+
+	assert.Eventually(t, func() bool {
+		namespaces, err := kubeController.ListMonitoredNamespaces()
+		return err == nil && len(namespaces) == 2
+	}, nsInformerSyncTimeout, 10*time.Millisecond)
+
+	assert.NoError(t, publisher.reconcile(context.TODO()))
+
+	for i := 0; i < 2; i++ {
+		cm, err := kubeClient.CoreV1().ConfigMaps(fmt.Sprintf("monitored-%d", i)).Get(context.TODO(), TrustBundleConfigMapName, metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, "root-ca-pem", cm.Data[TrustBundleConfigMapRootCertKey])
+		assert.Empty(t, cm.OwnerReferences, "the ConfigMap must not carry a cross-namespace owner reference")
+	}
+
+	_, err = kubeClient.CoreV1().ConfigMaps("unmonitored").Get(context.TODO(), TrustBundleConfigMapName, metav1.GetOptions{})
+	assert.Error(t, err)
+}
+
+func TestTrustBundlePublisherReconcileDeletesOnUnmonitor(t *testing.T) {
+	kubeClient := testclient.NewSimpleClientset()
+	stop := make(chan struct{})
+	defer close(stop)
+
+	kubeController, err := osmKube.NewKubernetesController(kubeClient, testMeshName, stop)
+	assert.NoError(t, err)
+
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "leaving",
+			Labels: map[string]string{constants.OSMKubeResourceMonitorAnnotation: testMeshName},
+		},
+	}
+	_, err = kubeClient.CoreV1().Namespaces().Create(context.TODO(), ns, metav1.CreateOptions{})
+	assert.NoError(t, err)
+
+	trustBundle := &fakeTrustBundle{pem: []byte("root-ca-pem")}
+	publisher := NewTrustBundlePublisher(kubeClient, kubeController, trustBundle)
+
+	assert.Eventually(t, func() bool {
+		namespaces, err := kubeController.ListMonitoredNamespaces()
+		return err == nil && len(namespaces) == 1
+	}, nsInformerSyncTimeout, 10*time.Millisecond)
+
+	assert.NoError(t, publisher.reconcile(context.TODO()))
+	_, err = kubeClient.CoreV1().ConfigMaps(ns.Name).Get(context.TODO(), TrustBundleConfigMapName, metav1.GetOptions{})
+	assert.NoError(t, err, "ConfigMap must be published while the namespace is monitored")
+
+	ns.Labels = nil
+	_, err = kubeClient.CoreV1().Namespaces().Update(context.TODO(), ns, metav1.UpdateOptions{})
+	assert.NoError(t, err)
+
+	assert.Eventually(t, func() bool {
+		namespaces, err := kubeController.ListMonitoredNamespaces()
+		return err == nil && len(namespaces) == 0
+	}, nsInformerSyncTimeout, 10*time.Millisecond)
+
+	assert.NoError(t, publisher.reconcile(context.TODO()))
+	_, err = kubeClient.CoreV1().ConfigMaps(ns.Name).Get(context.TODO(), TrustBundleConfigMapName, metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err), "ConfigMap must be deleted once its namespace leaves the mesh")
+}
@@ -0,0 +1,141 @@
+package webhook
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclient "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/openservicemesh/osm/pkg/apis/config/v1alpha2"
+	"github.com/openservicemesh/osm/pkg/certificate"
+	"github.com/openservicemesh/osm/pkg/certificate/providers/byo"
+)
+
+func fabricateCAKeyPair(t *testing.T, notBefore, notAfter time.Time) (crtPEM, keyPEM []byte) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-root"},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+
+	crtPEM = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM = pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return
+}
+
+func TestValidateSingleProvider(t *testing.T) {
+	testCases := []struct {
+		name    string
+		spec    v1alpha2.ProviderSpec
+		wantErr bool
+	}{
+		{"no provider set", v1alpha2.ProviderSpec{}, true},
+		{"tresor only", v1alpha2.ProviderSpec{Tresor: &v1alpha2.TresorProviderSpec{}}, false},
+		{"byo only", v1alpha2.ProviderSpec{BYO: &v1alpha2.BYOProviderSpec{}}, false},
+		{"tresor and byo both set", v1alpha2.ProviderSpec{Tresor: &v1alpha2.TresorProviderSpec{}, BYO: &v1alpha2.BYOProviderSpec{}}, true},
+		{"all four set", v1alpha2.ProviderSpec{
+			CertManager: &v1alpha2.CertManagerProviderSpec{},
+			Vault:       &v1alpha2.VaultProviderSpec{},
+			Tresor:      &v1alpha2.TresorProviderSpec{},
+			BYO:         &v1alpha2.BYOProviderSpec{},
+		}, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateSingleProvider(tc.spec)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateMeshRootCertificateBYO(t *testing.T) {
+	t.Run("rejects a BYO secret expiring within RenewBeforeCertExpires", func(t *testing.T) {
+		crtPEM, keyPEM := fabricateCAKeyPair(t, time.Now().Add(-time.Hour), time.Now().Add(certificate.RenewBeforeCertExpires/2))
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "byo-ca", Namespace: "osm-system"},
+			Data: map[string][]byte{
+				byo.RootCertFileName: crtPEM,
+				byo.RootKeyFileName:  keyPEM,
+			},
+		}
+		kubeClient := testclient.NewSimpleClientset(secret)
+
+		mrc := &v1alpha2.MeshRootCertificate{
+			ObjectMeta: metav1.ObjectMeta{Name: "mrc", Namespace: secret.Namespace},
+			Spec: v1alpha2.MeshRootCertificateSpec{
+				Provider: v1alpha2.ProviderSpec{BYO: &v1alpha2.BYOProviderSpec{SecretName: secret.Name}},
+			},
+		}
+
+		err := ValidateMeshRootCertificate(context.Background(), kubeClient, mrc)
+		assert.Error(t, err)
+	})
+
+	t.Run("accepts a valid BYO secret", func(t *testing.T) {
+		crtPEM, keyPEM := fabricateCAKeyPair(t, time.Now().Add(-time.Hour), time.Now().Add(365*24*time.Hour))
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "byo-ca", Namespace: "osm-system"},
+			Data: map[string][]byte{
+				byo.RootCertFileName: crtPEM,
+				byo.RootKeyFileName:  keyPEM,
+			},
+		}
+		kubeClient := testclient.NewSimpleClientset(secret)
+
+		mrc := &v1alpha2.MeshRootCertificate{
+			ObjectMeta: metav1.ObjectMeta{Name: "mrc", Namespace: secret.Namespace},
+			Spec: v1alpha2.MeshRootCertificateSpec{
+				Provider: v1alpha2.ProviderSpec{BYO: &v1alpha2.BYOProviderSpec{SecretName: secret.Name}},
+			},
+		}
+
+		assert.NoError(t, ValidateMeshRootCertificate(context.Background(), kubeClient, mrc))
+	})
+
+	t.Run("rejects multiple provider fields before ever looking at the secret", func(t *testing.T) {
+		kubeClient := testclient.NewSimpleClientset()
+		mrc := &v1alpha2.MeshRootCertificate{
+			ObjectMeta: metav1.ObjectMeta{Name: "mrc", Namespace: "osm-system"},
+			Spec: v1alpha2.MeshRootCertificateSpec{
+				Provider: v1alpha2.ProviderSpec{
+					Tresor: &v1alpha2.TresorProviderSpec{},
+					BYO:    &v1alpha2.BYOProviderSpec{SecretName: "does-not-exist"},
+				},
+			},
+		}
+
+		assert.Error(t, ValidateMeshRootCertificate(context.Background(), kubeClient, mrc))
+	})
+}